@@ -22,7 +22,6 @@ import (
 	"reflect"
 	"strconv"
 	"syscall"
-	"time"
 
 	"k8s.io/client-go/tools/cache"
 
@@ -115,6 +114,9 @@ func registerServe(app *kingpin.Application) (*kingpin.CmdClause, *serveContext)
 	serve.Flag("accesslog-format", "Format for Envoy access logs.").StringVar(&ctx.AccessLogFormat)
 	serve.Flag("disable-leader-election", "Disable leader election mechanism.").BoolVar(&ctx.DisableLeaderElection)
 
+	serve.Flag("holdoff-delay", "Delay before rebuilding the DAG after an update.").DurationVar(&ctx.HoldoffDelay)
+	serve.Flag("holdoff-max-delay", "Maximum delay before rebuilding the DAG while updates are pending.").DurationVar(&ctx.HoldoffMaxDelay)
+
 	serve.Flag("use-extensions-v1beta1-ingress", "Subscribe to the deprecated extensions/v1beta1.Ingress type.").BoolVar(&ctx.UseExtensionsV1beta1Ingress)
 	return serve, ctx
 }
@@ -161,8 +163,8 @@ func doServe(log logrus.FieldLogger, ctx *serveContext) error {
 			ListenerCache: contour.NewListenerCache(ctx.statsAddr, ctx.statsPort),
 			FieldLogger:   log.WithField("context", "CacheHandler"),
 		},
-		HoldoffDelay:    100 * time.Millisecond,
-		HoldoffMaxDelay: 500 * time.Millisecond,
+		HoldoffDelay:    ctx.HoldoffDelay,
+		HoldoffMaxDelay: ctx.HoldoffMaxDelay,
 		StatusClient: &k8s.StatusWriter{
 			Client: clients.contour,
 		},
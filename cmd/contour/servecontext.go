@@ -100,6 +100,14 @@ type serveContext struct {
 	// RequestTimeout sets the client request timeout globally for Contour.
 	RequestTimeout time.Duration `yaml:"request-timeout,omitempty"`
 
+	// HoldoffDelay is the time Contour waits after an update before
+	// rebuilding the DAG, so that bursts of updates are batched.
+	HoldoffDelay time.Duration `yaml:"holdoff-delay,omitempty"`
+
+	// HoldoffMaxDelay is the maximum time Contour delays a DAG rebuild
+	// while updates are still arriving.
+	HoldoffMaxDelay time.Duration `yaml:"holdoff-max-delay,omitempty"`
+
 	// Should Contour fall back to registering an informer for the deprecated
 	// extensions/v1beta1.Ingress type.
 	// By default this value is false, meaning Contour will register an informer for
@@ -133,6 +141,8 @@ func newServeContext() *serveContext {
 		DisablePermitInsecure: false,
 		DisableLeaderElection: false,
 		AccessLogFormat:       "envoy",
+		HoldoffDelay:          100 * time.Millisecond,
+		HoldoffMaxDelay:       500 * time.Millisecond,
 		AccessLogFields: []string{
 			"@timestamp",
 			"authority",
@@ -176,6 +176,18 @@ leaderelection:
 				return ctx
 			},
 		},
+		"holdoff delays": {
+			yamlIn: `
+holdoff-delay: 250ms
+holdoff-max-delay: 2s
+`,
+			want: func() *serveContext {
+				ctx := newServeContext()
+				ctx.HoldoffDelay = 250 * time.Millisecond
+				ctx.HoldoffMaxDelay = 2 * time.Second
+				return ctx
+			},
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
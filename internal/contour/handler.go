@@ -26,6 +26,7 @@ import (
 	"github.com/projectcontour/contour/internal/dag"
 	"github.com/projectcontour/contour/internal/k8s"
 	"github.com/projectcontour/contour/internal/metrics"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -219,7 +220,10 @@ func (e *EventHandler) incSequence() {
 // updateDAG builds a new DAG and sends it to the CacheHandler
 // the updates the status on objects and updates the metrics.
 func (e *EventHandler) updateDAG() {
+	timer := prometheus.NewTimer(e.DAGRebuildSummary)
 	dag := e.Builder.Build()
+	timer.ObserveDuration()
+
 	e.CacheHandler.OnChange(dag)
 
 	select {
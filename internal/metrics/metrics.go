@@ -41,6 +41,7 @@ type Metrics struct {
 	proxyOrphanedGauge  *prometheus.GaugeVec
 
 	dagRebuildGauge             *prometheus.GaugeVec
+	DAGRebuildSummary           prometheus.Summary
 	CacheHandlerOnUpdateSummary prometheus.Summary
 	ResourceEventHandlerSummary *prometheus.SummaryVec
 
@@ -77,6 +78,7 @@ const (
 	HTTPProxyOrphanedGauge  = "contour_httpproxy_orphaned_total"
 
 	DAGRebuildGauge             = "contour_dagrebuild_timestamp"
+	dagRebuildSummary           = "contour_dagrebuild_duration_seconds"
 	cacheHandlerOnUpdateSummary = "contour_cachehandler_onupdate_duration_seconds"
 	resourceEventHandlerSummary = "contour_resourceeventhandler_duration_seconds"
 )
@@ -168,6 +170,11 @@ func NewMetrics(registry *prometheus.Registry) *Metrics {
 			},
 			[]string{},
 		),
+		DAGRebuildSummary: prometheus.NewSummary(prometheus.SummaryOpts{
+			Name:       dagRebuildSummary,
+			Help:       "Histogram for the runtime of DAG rebuilds.",
+			Objectives: map[float64]float64{0.5: 0.05, 0.9: 0.01, 0.99: 0.001},
+		}),
 		CacheHandlerOnUpdateSummary: prometheus.NewSummary(prometheus.SummaryOpts{
 			Name:       cacheHandlerOnUpdateSummary,
 			Help:       "Histogram for the runtime of xDS cache regeneration.",
@@ -199,6 +206,7 @@ func (m *Metrics) register(registry *prometheus.Registry) {
 		m.proxyValidGauge,
 		m.proxyOrphanedGauge,
 		m.dagRebuildGauge,
+		m.DAGRebuildSummary,
 		m.CacheHandlerOnUpdateSummary,
 		m.ResourceEventHandlerSummary,
 	)
@@ -225,6 +233,7 @@ func (m *Metrics) Zero() {
 	m.SetIngressRouteMetric(zeroes)
 	m.SetHTTPProxyMetric(zeroes)
 
+	defer prometheus.NewTimer(m.DAGRebuildSummary).ObserveDuration()
 	defer prometheus.NewTimer(m.CacheHandlerOnUpdateSummary).ObserveDuration()
 
 	// TODO(jpeach) add ResourceEventHandlerSummary when it gets used